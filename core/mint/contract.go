@@ -0,0 +1,92 @@
+// Package mint defines the privileged mint contract: a fixed-address,
+// natively-executed contract that lets a configured owner set mint new
+// native balance in exchange for a proof of a corresponding burn on another
+// chain.
+package mint
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StorageLayout enumerates the storage slots used by the mint contract.
+// Slots are plain incrementing keys; there is no Solidity-style layout
+// since the contract is executed natively rather than interpreted.
+type StorageLayout struct {
+	// Owner holds MintContractConfig.OwnerAddress as installed by the
+	// migration. Mint authorization is checked against the owner set (see
+	// OwnersRoot and Threshold), not against this slot.
+	Owner common.Hash
+	// MintLimit holds the remaining mint budget as a big-endian uint256.
+	MintLimit common.Hash
+	// ProcessedBurns is the base slot for the per-network replay-protection
+	// mapping keccak256(network || burnTxHash || ProcessedBurns) => blockNumber
+	// in which the burn tx was minted. A non-zero value marks the
+	// (network, burnTxHash) pair as already processed.
+	ProcessedBurns common.Hash
+	// NetworksRoot holds keccak256 of the RLP-encoded []params.MintNetworkConfig
+	// installed by the migration, so a reader of state can confirm it matches
+	// the in-memory mint.Verifiers registry without trusting the node.
+	NetworksRoot common.Hash
+	// LastRefillBlock holds the block number the rate-limited budget was last
+	// refilled at. Only meaningful when Refill is configured.
+	LastRefillBlock common.Hash
+	// CurrentBudget holds the remaining rate-limited mint budget as a
+	// big-endian uint256. Only meaningful when Refill is configured; legacy,
+	// non-refilling deployments debit MintLimit directly instead.
+	CurrentBudget common.Hash
+	// OwnersRoot holds keccak256 of the sorted owner address set installed by
+	// the migration, so a reader of state can confirm it matches the
+	// in-memory mint.Owners set without trusting the node.
+	OwnersRoot common.Hash
+	// Threshold holds the number of owner signatures required to authorize a
+	// mint or owner-rotation transaction, as a big-endian uint256.
+	Threshold common.Hash
+	// OwnerNonce holds the replay-protection counter owners sign over when
+	// authorizing a mint or owner-rotation transaction, as a big-endian
+	// uint256. It is incremented on every successful owner-authorized action.
+	OwnerNonce common.Hash
+	// OwnerCount holds the number of owners in the persisted owner list, as
+	// a big-endian uint256.
+	OwnerCount common.Hash
+	// Owners is the base slot for the persisted owner list, mapping
+	// keccak256(index || Owners) => owner address for index in
+	// [0, OwnerCount). It lets a restarted node reload the owner set
+	// RotateOwners last wrote, rather than reverting to whatever
+	// MintContractConfig.Owners the migration was called with.
+	Owners common.Hash
+}
+
+// MintContract describes the fixed mint contract: its address, the bytecode
+// installed at that address (used only to mark the account as a contract and
+// to detect tampering), and the storage slots it uses.
+type MintContract struct {
+	Address       common.Address
+	Bytecode      []byte
+	StorageLayout StorageLayout
+}
+
+func slot(i int64) common.Hash {
+	return common.BigToHash(big.NewInt(i))
+}
+
+// Contract is the singleton mint contract installed by
+// migrations.NewMintContractMigration.
+var Contract = MintContract{
+	Address:  common.HexToAddress("0x000000000000000000000000000000000000f1"),
+	Bytecode: common.FromHex("0x608060405234801561001057600080fd5b50600436106100365760003560e01c80638da5cb5b1461003b578063d0e30db014610059575b600080fd5b610043610063565b60405161005091906100a1565b60405180910390f35b610061610087565b005b6000805473ffffffffffffffffffffffffffffffffffffffff16905090565b565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b6100a48161007f565b82525050565b60006020820190506100bf600083018461009b565b9291505056fea2646970667358221220000000000000000000000000000000000000000000000000000000000000000064736f6c63430008070033"),
+	StorageLayout: StorageLayout{
+		Owner:           slot(0),
+		MintLimit:       slot(1),
+		ProcessedBurns:  slot(2),
+		NetworksRoot:    slot(3),
+		LastRefillBlock: slot(4),
+		CurrentBudget:   slot(5),
+		OwnersRoot:      slot(6),
+		Threshold:       slot(7),
+		OwnerNonce:      slot(8),
+		OwnerCount:      slot(9),
+		Owners:          slot(10),
+	},
+}
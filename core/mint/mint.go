@@ -0,0 +1,187 @@
+package mint
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// headerLength is the size, in bytes, of a mint instruction's fixed header:
+// mintAmount(32) | burnTxHash(32) | burnTxNetwork(1) | proofLen(4). The
+// header is followed by proofLen bytes of verifier-specific proof; any bytes
+// after the proof are read as the owner signature block (see authorize).
+const headerLength = 32 + 32 + 1 + 4
+
+// eventTopic is the signature topic of the Mint event emitted on every
+// successful mint.
+var eventTopic = common.HexToHash("0d9811f14a9cfa628d4819902adcdd4ff09f73ac9c2628280058dc2146fa247d")
+
+// budgetRefilledTopic is the signature topic of the BudgetRefilled event
+// emitted whenever the rate-limited budget actually refills.
+var budgetRefilledTopic = common.HexToHash("8a2a9e2c7dca6b0e2c3c0c9b0e28c0f7a1e9f8f1a5f2e6c4b3d7a9c1e0f4b6a2")
+
+// RefillConfig switches the mint budget from the legacy monotonically
+// decreasing MintLimit to a rate-limited budget that automatically refills
+// by RefillAmount every RefillPeriodBlocks, capped at MaxBudget. A nil
+// Refill (the default) preserves the legacy behavior.
+type RefillConfig struct {
+	RefillAmount       *big.Int
+	RefillPeriodBlocks uint64
+	MaxBudget          *big.Int
+}
+
+// Refill configures the rate-limited budget. It is populated, alongside
+// Verifiers, by migrations.NewMintContractMigration.
+var Refill *RefillConfig
+
+// MinTipCap, when non-nil, is the minimum priority fee ApplyMessage requires
+// of a mint transaction before it even reaches Execute. It is populated by
+// migrations.NewMintContractMigration.
+var MinTipCap *big.Int
+
+// ProcessedBurnSlot derives the storage slot backing
+// StorageLayout.ProcessedBurns[network][burnTxHash].
+func ProcessedBurnSlot(network byte, burnTxHash common.Hash) common.Hash {
+	return crypto.Keccak256Hash(append([]byte{network}, append(burnTxHash.Bytes(), Contract.StorageLayout.ProcessedBurns.Bytes()...)...))
+}
+
+// refillBudget advances CurrentBudget by as many RefillPeriodBlocks as have
+// elapsed since LastRefillBlock, capped at MaxBudget, persists the result,
+// and emits a BudgetRefilled log when a refill actually occurred. It returns
+// the (possibly just-refilled) budget.
+//
+// LastRefillBlock starts out zero (the migration does not know the
+// deployment block), so the first call lazily seeds it to the current
+// blockNumber instead of treating the zero value as "deployed at block 0",
+// which would otherwise grant floor(blockNumber/RefillPeriodBlocks) phantom
+// refills on the very first mint after deployment.
+func refillBudget(stateDb vm.StateDB, blockNumber uint64) *big.Int {
+	lastRefillBlock := stateDb.GetState(Contract.Address, Contract.StorageLayout.LastRefillBlock).Big().Uint64()
+	budget := stateDb.GetState(Contract.Address, Contract.StorageLayout.CurrentBudget).Big()
+
+	if lastRefillBlock == 0 {
+		stateDb.SetState(Contract.Address, Contract.StorageLayout.LastRefillBlock, common.BigToHash(new(big.Int).SetUint64(blockNumber)))
+		return budget
+	}
+
+	elapsed := uint64(0)
+	if blockNumber > lastRefillBlock {
+		elapsed = (blockNumber - lastRefillBlock) / Refill.RefillPeriodBlocks
+	}
+	if elapsed == 0 {
+		return budget
+	}
+
+	refilled := new(big.Int).Add(budget, new(big.Int).Mul(new(big.Int).SetUint64(elapsed), Refill.RefillAmount))
+	if refilled.Cmp(Refill.MaxBudget) > 0 {
+		refilled = new(big.Int).Set(Refill.MaxBudget)
+	}
+	lastRefillBlock += elapsed * Refill.RefillPeriodBlocks
+
+	stateDb.SetState(Contract.Address, Contract.StorageLayout.LastRefillBlock, common.BigToHash(new(big.Int).SetUint64(lastRefillBlock)))
+	stateDb.SetState(Contract.Address, Contract.StorageLayout.CurrentBudget, common.BigToHash(refilled))
+
+	stateDb.AddLog(&types.Log{
+		Address:     Contract.Address,
+		Topics:      []common.Hash{budgetRefilledTopic},
+		Data:        common.BigToHash(refilled).Bytes(),
+		BlockNumber: blockNumber,
+	})
+
+	return refilled
+}
+
+// Execute runs the mint instruction carried by data (the tx data with the
+// leading Opcode byte already stripped by the caller) on behalf of sender
+// against stateDb, crediting mintAmount to sender's balance and debiting the
+// mint budget. blockNumber is the number of the block the instruction is
+// executed in, used both for the processed-burn bookkeeping and for the
+// emitted log entry.
+//
+// Every rejection path returns vm.ErrExecutionReverted after logging a
+// human-readable reason via log.Warn, matching the revert contract the rest
+// of the privileged-tx handling in ApplyMessage relies on.
+func Execute(stateDb vm.StateDB, sender common.Address, data []byte, blockNumber uint64) error {
+	if len(stateDb.GetCode(Contract.Address)) != len(Contract.Bytecode) {
+		log.Warn("mint contract not found in current state")
+		return vm.ErrExecutionReverted
+	}
+	if len(data) < headerLength {
+		log.Warn("invalid mint instruction")
+		return vm.ErrExecutionReverted
+	}
+
+	mintAmount := new(big.Int).SetBytes(data[:32])
+	burnTxHash := common.BytesToHash(data[32:64])
+	burnTxNetwork := data[64]
+	proofLen := binary.BigEndian.Uint32(data[65:69])
+
+	if uint64(len(data)) < uint64(headerLength)+uint64(proofLen) {
+		log.Warn("invalid mint instruction")
+		return vm.ErrExecutionReverted
+	}
+	proof := data[headerLength : uint64(headerLength)+uint64(proofLen)]
+	tail := data[uint64(headerLength)+uint64(proofLen):]
+
+	ownerNonce := stateDb.GetState(Contract.Address, Contract.StorageLayout.OwnerNonce).Big().Uint64()
+	if err := authorize(sender, tail, MintSigningHash(ChainID, mintAmount, burnTxHash, burnTxNetwork, ownerNonce)); err != nil {
+		log.Warn(err.Error())
+		return vm.ErrExecutionReverted
+	}
+
+	verifier, ok := Verifiers[burnTxNetwork]
+	if !ok {
+		log.Warn("invalid burn tx network in mint instruction")
+		return vm.ErrExecutionReverted
+	}
+	if binder, ok := verifier.(stateBinder); ok {
+		binder.bindState(stateDb)
+	}
+	if err := verifier.Verify(burnTxNetwork, burnTxHash, mintAmount, proof); err != nil {
+		log.Warn("burn proof verification failed", "reason", err)
+		return vm.ErrExecutionReverted
+	}
+
+	burnSlot := ProcessedBurnSlot(burnTxNetwork, burnTxHash)
+	if stateDb.GetState(Contract.Address, burnSlot) != (common.Hash{}) {
+		log.Warn("burn tx already processed")
+		return vm.ErrExecutionReverted
+	}
+
+	budgetSlot := Contract.StorageLayout.MintLimit
+	budget := stateDb.GetState(Contract.Address, budgetSlot).Big()
+
+	if Refill != nil && Refill.RefillPeriodBlocks > 0 {
+		budgetSlot = Contract.StorageLayout.CurrentBudget
+		budget = refillBudget(stateDb, blockNumber)
+	}
+
+	if mintAmount.Cmp(budget) > 0 {
+		log.Warn("mint amount exceeds mint limit")
+		return vm.ErrExecutionReverted
+	}
+
+	stateDb.SetState(Contract.Address, burnSlot, common.BigToHash(new(big.Int).SetUint64(blockNumber)))
+	stateDb.SetState(Contract.Address, budgetSlot, common.BigToHash(new(big.Int).Sub(budget, mintAmount)))
+	stateDb.SetState(Contract.Address, Contract.StorageLayout.OwnerNonce, common.BigToHash(new(big.Int).SetUint64(ownerNonce+1)))
+	stateDb.AddBalance(sender, mintAmount)
+
+	stateDb.AddLog(&types.Log{
+		Address: Contract.Address,
+		Topics:  []common.Hash{eventTopic, common.BytesToHash([]byte{burnTxNetwork})},
+		Data: bytes.Join([][]byte{
+			common.BigToHash(mintAmount).Bytes(),
+			burnTxHash.Bytes(),
+			common.BytesToHash([]byte{burnTxNetwork}).Bytes(),
+		}, []byte{}),
+		BlockNumber: blockNumber,
+	})
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package mint
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Opcode selects which privileged operation a transaction sent to
+// Contract.Address performs. It is the first byte of the transaction data;
+// the remainder is interpreted according to the opcode by Execute or
+// RotateOwners.
+type Opcode byte
+
+const (
+	// OpcodeMint mints tokens against a verified burn, see Execute.
+	OpcodeMint Opcode = 0x00
+	// OpcodeRotateOwners replaces the owner set and threshold, see RotateOwners.
+	OpcodeRotateOwners Opcode = 0x01
+)
+
+// sigLength is the size, in bytes, of a single recoverable ECDSA signature.
+const sigLength = 65
+
+// ownersRotatedTopic is the signature topic of the OwnersRotated event
+// emitted whenever the owner set is replaced.
+var ownersRotatedTopic = common.HexToHash("e32c3c6c1d8c0f9a8e6f1c2b3a4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f")
+
+// Owners is the current owner set authorized to submit mint and
+// owner-rotation transactions, and Threshold is how many of them must sign.
+// Both are populated by migrations.NewMintContractMigration. When
+// len(Owners) == 1 && Threshold == 1, mint and owner-rotation transactions
+// fall back to a plain tx-sender check instead of requiring an attached
+// signature block, preserving the original single-owner semantics.
+var (
+	Owners    []common.Address
+	Threshold uint8
+)
+
+// ChainID binds owner signatures to a specific chain. It is populated
+// alongside Owners.
+var ChainID *big.Int
+
+// OwnersRoot returns keccak256 of the sorted owners, the commitment stored
+// at StorageLayout.OwnersRoot.
+func OwnersRoot(owners []common.Address) common.Hash {
+	sorted := make([]common.Address, len(owners))
+	copy(sorted, owners)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i].Bytes(), sorted[j].Bytes()) < 0 })
+
+	var buf bytes.Buffer
+	for _, owner := range sorted {
+		buf.Write(owner.Bytes())
+	}
+	return crypto.Keccak256Hash(buf.Bytes())
+}
+
+// OwnerSlot derives the storage slot backing the owner address at index in
+// the persisted owner list, StorageLayout.OwnerCount being its length.
+func OwnerSlot(index uint64) common.Hash {
+	return crypto.Keccak256Hash(append(common.BigToHash(new(big.Int).SetUint64(index)).Bytes(), Contract.StorageLayout.Owners.Bytes()...))
+}
+
+// PersistOwners writes owners to the persisted owner list backing
+// StorageLayout.OwnerCount/Owners, so a restarted node can reload the set
+// RotateOwners last installed via LoadOwners, instead of reverting to
+// whatever MintContractConfig.Owners a fresh migration call is given.
+func PersistOwners(stateDb vm.StateDB, owners []common.Address) {
+	stateDb.SetState(Contract.Address, Contract.StorageLayout.OwnerCount, common.BigToHash(new(big.Int).SetUint64(uint64(len(owners)))))
+	for i, owner := range owners {
+		stateDb.SetState(Contract.Address, OwnerSlot(uint64(i)), owner.Hash())
+	}
+}
+
+// LoadOwners reconstructs the owner list last written by PersistOwners. ok
+// is false when StorageLayout.OwnerCount is zero, meaning the contract has
+// not been installed into stateDb yet.
+func LoadOwners(stateDb vm.StateDB) (owners []common.Address, ok bool) {
+	count := stateDb.GetState(Contract.Address, Contract.StorageLayout.OwnerCount).Big().Uint64()
+	if count == 0 {
+		return nil, false
+	}
+	owners = make([]common.Address, count)
+	for i := range owners {
+		owners[i] = common.BytesToAddress(stateDb.GetState(Contract.Address, OwnerSlot(uint64(i))).Bytes())
+	}
+	return owners, true
+}
+
+// MintSigningHash is the digest owners sign to authorize minting amount
+// against burnTxHash on network, at the given owner nonce.
+func MintSigningHash(chainID *big.Int, amount *big.Int, burnTxHash common.Hash, network byte, ownerNonce uint64) common.Hash {
+	nonce := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonce, ownerNonce)
+	return crypto.Keccak256Hash(bytes.Join([][]byte{
+		common.BigToHash(chainID).Bytes(),
+		common.BigToHash(amount).Bytes(),
+		burnTxHash.Bytes(),
+		{network},
+		nonce,
+	}, []byte{}))
+}
+
+// RotationSigningHash is the digest owners sign to authorize replacing the
+// owner set with newOwners and newThreshold, at the given owner nonce.
+func RotationSigningHash(chainID *big.Int, newOwners []common.Address, newThreshold uint8, ownerNonce uint64) common.Hash {
+	nonce := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonce, ownerNonce)
+	return crypto.Keccak256Hash(bytes.Join([][]byte{
+		common.BigToHash(chainID).Bytes(),
+		OwnersRoot(newOwners).Bytes(),
+		{newThreshold},
+		nonce,
+	}, []byte{}))
+}
+
+// authorize checks that the action digested by signingHash is authorized,
+// either because sender is the sole configured owner (the legacy
+// single-owner fallback) or because tail carries a sigCount(1) |
+// sig1(65) | sig2(65) | ... block with at least Threshold valid, distinct
+// signatures by members of Owners.
+func authorize(sender common.Address, tail []byte, signingHash common.Hash) error {
+	if len(Owners) == 1 && Threshold == 1 {
+		if sender != Owners[0] {
+			return errors.New("transaction sender is not allowed to mint")
+		}
+		return nil
+	}
+
+	if len(tail) < 1 {
+		return errors.New("missing owner signature block")
+	}
+	sigCount := int(tail[0])
+	sigs := tail[1:]
+	if len(sigs) != sigCount*sigLength {
+		return errors.New("missing owner signature block")
+	}
+
+	seen := make(map[common.Address]bool, sigCount)
+	valid := 0
+	for i := 0; i < sigCount; i++ {
+		pubKey, err := crypto.SigToPub(signingHash.Bytes(), sigs[i*sigLength:(i+1)*sigLength])
+		if err != nil {
+			continue
+		}
+		signer := crypto.PubkeyToAddress(*pubKey)
+		if seen[signer] {
+			continue
+		}
+		for _, owner := range Owners {
+			if owner == signer {
+				seen[signer] = true
+				valid++
+				break
+			}
+		}
+	}
+	if valid < int(Threshold) {
+		return errors.New("insufficient owner signatures")
+	}
+	return nil
+}
+
+// RotateOwners replaces the current owner set and threshold, authorized by
+// Threshold signatures from the current Owners (or a plain sender check in
+// single-owner mode, see authorize). data is laid out as:
+// newThreshold(1) | ownerCount(1) | owner1(20) | owner2(20) | ... |
+// sigCount(1) | sig1(65) | sig2(65) | ...
+func RotateOwners(stateDb vm.StateDB, sender common.Address, data []byte, blockNumber uint64) error {
+	if len(data) < 2 {
+		log.Warn("invalid owner rotation instruction")
+		return vm.ErrExecutionReverted
+	}
+	newThreshold := data[0]
+	ownerCount := int(data[1])
+	data = data[2:]
+	if newThreshold == 0 || int(newThreshold) > ownerCount || len(data) < ownerCount*common.AddressLength {
+		log.Warn("invalid owner rotation instruction")
+		return vm.ErrExecutionReverted
+	}
+
+	newOwners := make([]common.Address, ownerCount)
+	for i := 0; i < ownerCount; i++ {
+		newOwners[i] = common.BytesToAddress(data[i*common.AddressLength : (i+1)*common.AddressLength])
+	}
+	tail := data[ownerCount*common.AddressLength:]
+
+	ownerNonce := stateDb.GetState(Contract.Address, Contract.StorageLayout.OwnerNonce).Big().Uint64()
+	signingHash := RotationSigningHash(ChainID, newOwners, newThreshold, ownerNonce)
+	if err := authorize(sender, tail, signingHash); err != nil {
+		log.Warn(err.Error())
+		return vm.ErrExecutionReverted
+	}
+
+	Owners = newOwners
+	Threshold = newThreshold
+
+	ownersRoot := OwnersRoot(newOwners)
+	stateDb.SetState(Contract.Address, Contract.StorageLayout.OwnersRoot, ownersRoot)
+	stateDb.SetState(Contract.Address, Contract.StorageLayout.Threshold, common.BigToHash(new(big.Int).SetUint64(uint64(newThreshold))))
+	stateDb.SetState(Contract.Address, Contract.StorageLayout.OwnerNonce, common.BigToHash(new(big.Int).SetUint64(ownerNonce+1)))
+	PersistOwners(stateDb, newOwners)
+
+	stateDb.AddLog(&types.Log{
+		Address:     Contract.Address,
+		Topics:      []common.Hash{ownersRotatedTopic},
+		Data:        ownersRoot.Bytes(),
+		BlockNumber: blockNumber,
+	})
+
+	return nil
+}
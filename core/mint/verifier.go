@@ -0,0 +1,176 @@
+package mint
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// BurnVerifier authenticates that amount of native tokens were genuinely
+// burned on a source network, identified by burnTxHash, before the mint
+// contract allows them to be re-minted here. proof is verifier-specific: an
+// attester signature, a Merkle-Patricia receipt proof, etc.
+type BurnVerifier interface {
+	Verify(network byte, burnTxHash common.Hash, amount *big.Int, proof []byte) error
+}
+
+// VerifierRegistry maps a source network id to the verifier responsible for
+// authenticating burns reported from it.
+type VerifierRegistry map[byte]BurnVerifier
+
+// Verifiers is the process-wide registry consulted by Execute. It is
+// populated once, alongside the mint contract migration, from
+// params.MintContractConfig.Networks via BuildVerifierRegistry.
+var Verifiers = VerifierRegistry{}
+
+// stateBinder is implemented by verifiers that need a live view of state to
+// authenticate a proof, e.g. MerklePatriciaVerifier reading the receipts
+// root reported by a source-chain oracle contract.
+type stateBinder interface {
+	bindState(stateDb vm.StateDB)
+}
+
+// SigningHash is the digest a TrustedSignerVerifier proof must sign.
+func SigningHash(network byte, burnTxHash common.Hash, amount *big.Int) common.Hash {
+	return crypto.Keccak256Hash(append([]byte{network}, append(burnTxHash.Bytes(), common.BigToHash(amount).Bytes()...)...))
+}
+
+// TrustedSignerVerifier accepts a burn as genuine when proof is a 65-byte
+// ECDSA signature, by Attester, over SigningHash(network, burnTxHash, amount).
+// Configuring Attester as the legacy MintContractConfig.OwnerAddress
+// reproduces the original owner-only minting semantics.
+type TrustedSignerVerifier struct {
+	Attester common.Address
+}
+
+// Verify implements BurnVerifier.
+func (v *TrustedSignerVerifier) Verify(network byte, burnTxHash common.Hash, amount *big.Int, proof []byte) error {
+	if len(proof) != 65 {
+		return errors.New("trusted signer proof must be a 65-byte signature")
+	}
+	pubKey, err := crypto.SigToPub(SigningHash(network, burnTxHash, amount).Bytes(), proof)
+	if err != nil {
+		return fmt.Errorf("recovering attester signature: %w", err)
+	}
+	if crypto.PubkeyToAddress(*pubKey) != v.Attester {
+		return errors.New("proof is not signed by the configured attester")
+	}
+	return nil
+}
+
+// merklePatriciaProof is the RLP encoding of a MerklePatriciaVerifier proof:
+// the index of the burn tx's receipt within its block (the key the receipts
+// trie is keyed by) and the Merkle-Patricia trie nodes proving it.
+type merklePatriciaProof struct {
+	TxIndex uint64
+	Nodes   [][]byte
+}
+
+// merklePatriciaLeaf is the RLP encoding expected as the proven receipt's
+// trie value: the burn tx hash and amount it attests to. Committing
+// BurnTxHash into the leaf - rather than trusting the network/amount/proof
+// the caller of Verify supplies independently of it - binds the proof to
+// that specific burn, so a single genuine proof cannot be resubmitted with a
+// different claimed BurnTxHash to mint repeatedly past the
+// (network, burnTxHash) replay guard in Execute.
+type merklePatriciaLeaf struct {
+	BurnTxHash common.Hash
+	Amount     *big.Int
+}
+
+// MerklePatriciaVerifier accepts a burn as genuine when proof decodes to a
+// merklePatriciaProof proving, against the receiptsRoot most recently
+// reported by the source-chain block-hash oracle contract at
+// OracleAddress/OracleSlot, a receipt keyed by RLP(TxIndex) whose value
+// decodes to a merklePatriciaLeaf committing to burnTxHash and amount.
+type MerklePatriciaVerifier struct {
+	OracleAddress common.Address
+	OracleSlot    common.Hash
+
+	stateDb vm.StateDB
+}
+
+func (v *MerklePatriciaVerifier) bindState(stateDb vm.StateDB) {
+	v.stateDb = stateDb
+}
+
+// Verify implements BurnVerifier.
+func (v *MerklePatriciaVerifier) Verify(network byte, burnTxHash common.Hash, amount *big.Int, proof []byte) error {
+	if v.stateDb == nil {
+		return errors.New("merkle patricia verifier has no state bound")
+	}
+
+	var decoded merklePatriciaProof
+	if err := rlp.DecodeBytes(proof, &decoded); err != nil {
+		return fmt.Errorf("decoding merkle patricia proof: %w", err)
+	}
+
+	key, err := rlp.EncodeToBytes(decoded.TxIndex)
+	if err != nil {
+		return fmt.Errorf("encoding receipt trie key: %w", err)
+	}
+
+	proofDb := memorydb.New()
+	for _, node := range decoded.Nodes {
+		proofDb.Put(crypto.Keccak256(node), node)
+	}
+
+	receiptsRoot := v.stateDb.GetState(v.OracleAddress, v.OracleSlot)
+	value, err := trie.VerifyProof(receiptsRoot, key, proofDb)
+	if err != nil {
+		return fmt.Errorf("verifying merkle patricia proof: %w", err)
+	}
+
+	var leaf merklePatriciaLeaf
+	if err := rlp.DecodeBytes(value, &leaf); err != nil {
+		return fmt.Errorf("decoding proven receipt leaf: %w", err)
+	}
+	if leaf.BurnTxHash != burnTxHash {
+		return errors.New("proven receipt does not attest to this burn tx hash")
+	}
+	if leaf.Amount.Cmp(amount) != 0 {
+		return errors.New("proven burn amount does not match mint amount")
+	}
+	return nil
+}
+
+// BuildVerifierRegistry constructs a VerifierRegistry from config.Networks.
+// When config.Networks is empty it falls back to a single network (id 1)
+// backed by a TrustedSignerVerifier over config.OwnerAddress, preserving the
+// original owner-only minting semantics.
+func BuildVerifierRegistry(config *params.MintContractConfig) (VerifierRegistry, error) {
+	networks := config.Networks
+	if len(networks) == 0 {
+		networks = []params.MintNetworkConfig{{ID: 1, VerifierType: "trusted-signer", VerifierParams: config.OwnerAddress.Bytes()}}
+	}
+
+	registry := VerifierRegistry{}
+	for _, network := range networks {
+		switch network.VerifierType {
+		case "trusted-signer":
+			if len(network.VerifierParams) != common.AddressLength {
+				return nil, fmt.Errorf("network %d: trusted-signer verifier params must be a %d-byte address", network.ID, common.AddressLength)
+			}
+			registry[network.ID] = &TrustedSignerVerifier{Attester: common.BytesToAddress(network.VerifierParams)}
+		case "merkle-patricia":
+			if len(network.VerifierParams) != common.AddressLength+common.HashLength {
+				return nil, fmt.Errorf("network %d: merkle-patricia verifier params must be a %d-byte oracle address followed by a %d-byte slot", network.ID, common.AddressLength, common.HashLength)
+			}
+			registry[network.ID] = &MerklePatriciaVerifier{
+				OracleAddress: common.BytesToAddress(network.VerifierParams[:common.AddressLength]),
+				OracleSlot:    common.BytesToHash(network.VerifierParams[common.AddressLength:]),
+			}
+		default:
+			return nil, fmt.Errorf("network %d: unknown verifier type %q", network.ID, network.VerifierType)
+		}
+	}
+	return registry, nil
+}
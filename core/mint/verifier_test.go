@@ -0,0 +1,108 @@
+package mint
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	oracleAddress = common.HexToAddress("0x00000000000000000000000000000000000aaa")
+	oracleSlot    = common.HexToHash("0x01")
+)
+
+// buildReceiptProof builds a genuine receipts trie containing a single
+// receipt keyed by RLP(txIndex), whose value is a merklePatriciaLeaf
+// committing to burnTxHash and amount, and returns the trie's root (what the
+// oracle contract would report) alongside an encoded merklePatriciaProof
+// for it.
+func buildReceiptProof(t *testing.T, txIndex uint64, burnTxHash common.Hash, amount *big.Int) (common.Hash, []byte) {
+	tr, err := trie.New(common.Hash{}, trie.NewDatabase(rawdb.NewMemoryDatabase()))
+	assert.NoError(t, err)
+
+	key, err := rlp.EncodeToBytes(txIndex)
+	assert.NoError(t, err)
+	leaf, err := rlp.EncodeToBytes(merklePatriciaLeaf{BurnTxHash: burnTxHash, Amount: amount})
+	assert.NoError(t, err)
+	assert.NoError(t, tr.TryUpdate(key, leaf))
+
+	root := tr.Hash()
+
+	proofDb := memorydb.New()
+	assert.NoError(t, tr.Prove(key, 0, proofDb))
+
+	var nodes [][]byte
+	it := proofDb.NewIterator(nil, nil)
+	defer it.Release()
+	for it.Next() {
+		nodes = append(nodes, append([]byte{}, it.Value()...))
+	}
+
+	encoded, err := rlp.EncodeToBytes(merklePatriciaProof{TxIndex: txIndex, Nodes: nodes})
+	assert.NoError(t, err)
+
+	return root, encoded
+}
+
+func newBoundVerifier(t *testing.T, receiptsRoot common.Hash) *MerklePatriciaVerifier {
+	stateDb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	stateDb.SetState(oracleAddress, oracleSlot, receiptsRoot)
+
+	verifier := &MerklePatriciaVerifier{OracleAddress: oracleAddress, OracleSlot: oracleSlot}
+	verifier.bindState(stateDb)
+	return verifier
+}
+
+func TestMerklePatriciaVerifierAcceptsValidProof(t *testing.T) {
+	amount := big.NewInt(500)
+	burnTxHash := common.HexToHash("0x1111")
+	root, proof := buildReceiptProof(t, 3, burnTxHash, amount)
+
+	verifier := newBoundVerifier(t, root)
+
+	assert.NoError(t, verifier.Verify(1, burnTxHash, amount, proof))
+}
+
+func TestMerklePatriciaVerifierRejectsTamperedAmount(t *testing.T) {
+	amount := big.NewInt(500)
+	burnTxHash := common.HexToHash("0x1111")
+	root, proof := buildReceiptProof(t, 3, burnTxHash, amount)
+
+	verifier := newBoundVerifier(t, root)
+
+	tamperedAmount := new(big.Int).Add(amount, big.NewInt(1))
+	assert.Error(t, verifier.Verify(1, burnTxHash, tamperedAmount, proof))
+}
+
+func TestMerklePatriciaVerifierRejectsWrongRoot(t *testing.T) {
+	amount := big.NewInt(500)
+	burnTxHash := common.HexToHash("0x1111")
+	_, proof := buildReceiptProof(t, 3, burnTxHash, amount)
+
+	verifier := newBoundVerifier(t, common.HexToHash("0xdeadbeef"))
+
+	assert.Error(t, verifier.Verify(1, burnTxHash, amount, proof))
+}
+
+// TestMerklePatriciaVerifierRejectsReplayWithDifferentBurnTxHash guards
+// against resubmitting one genuine proof with a different claimed
+// burnTxHash to mint repeatedly past Execute's (network, burnTxHash) replay
+// guard, which keys on the caller-supplied burnTxHash rather than anything
+// the proof itself commits to.
+func TestMerklePatriciaVerifierRejectsReplayWithDifferentBurnTxHash(t *testing.T) {
+	amount := big.NewInt(500)
+	burnTxHash := common.HexToHash("0x1111")
+	root, proof := buildReceiptProof(t, 3, burnTxHash, amount)
+
+	verifier := newBoundVerifier(t, root)
+
+	otherBurnTxHash := common.HexToHash("0x2222")
+	assert.Error(t, verifier.Verify(1, otherBurnTxHash, amount, proof))
+}
@@ -0,0 +1,112 @@
+// Package migrations contains one-off state mutations applied to a chain's
+// state, such as installing the privileged mint contract.
+package migrations
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/mint"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// NewMintContractMigration validates config and returns a migration that
+// installs the mint contract bytecode and its initial storage - owner, mint
+// limit, verifier config commitment, and any pre-seeded processed burns -
+// into a state.StateDB. It also populates the process-wide mint.Verifiers
+// registry so that subsequent mint transactions can be authenticated.
+func NewMintContractMigration(config *params.MintContractConfig) (func(stateDb *state.StateDB), error) {
+	if config.OwnerAddress == (common.Address{}) {
+		return nil, errors.New("owner address is not specified or equals to zero address")
+	}
+	if config.MintLimit == nil {
+		return nil, errors.New("mint limit is not specified")
+	}
+	if config.RefillPeriodBlocks > 0 {
+		if config.RefillAmount == nil {
+			return nil, errors.New("refill amount is not specified")
+		}
+		if config.MaxBudget == nil {
+			return nil, errors.New("max budget is not specified")
+		}
+	}
+
+	owners := config.Owners
+	threshold := config.Threshold
+	if len(owners) == 0 {
+		owners = []common.Address{config.OwnerAddress}
+		threshold = 1
+	}
+	if threshold == 0 {
+		return nil, errors.New("owner threshold is not specified")
+	}
+	if int(threshold) > len(owners) {
+		return nil, errors.New("owner threshold exceeds the number of owners")
+	}
+
+	registry, err := mint.BuildVerifierRegistry(config)
+	if err != nil {
+		return nil, err
+	}
+
+	networks := config.Networks
+	if len(networks) == 0 {
+		networks = []params.MintNetworkConfig{{ID: 1, VerifierType: "trusted-signer", VerifierParams: config.OwnerAddress.Bytes()}}
+	}
+	encodedNetworks, err := rlp.EncodeToBytes(networks)
+	if err != nil {
+		return nil, fmt.Errorf("encoding network verifier config: %w", err)
+	}
+	networksRoot := crypto.Keccak256Hash(encodedNetworks)
+
+	return func(stateDb *state.StateDB) {
+		mint.Verifiers = registry
+
+		if config.RefillPeriodBlocks > 0 {
+			mint.Refill = &mint.RefillConfig{
+				RefillAmount:       config.RefillAmount,
+				RefillPeriodBlocks: config.RefillPeriodBlocks,
+				MaxBudget:          config.MaxBudget,
+			}
+		} else {
+			mint.Refill = nil
+		}
+		mint.MinTipCap = config.MinTipCap
+		mint.ChainID = config.ChainID
+
+		// A prior RotateOwners call may have replaced the owner set since
+		// this contract was last installed; reload it from state rather
+		// than silently reverting to config.Owners, so rotations survive a
+		// node restart that re-runs this migration.
+		finalOwners, finalThreshold := owners, threshold
+		if persisted, ok := mint.LoadOwners(stateDb); ok {
+			finalOwners = persisted
+			finalThreshold = uint8(stateDb.GetState(mint.Contract.Address, mint.Contract.StorageLayout.Threshold).Big().Uint64())
+		}
+		mint.Owners = finalOwners
+		mint.Threshold = finalThreshold
+
+		stateDb.SetCode(mint.Contract.Address, mint.Contract.Bytecode)
+		stateDb.SetState(mint.Contract.Address, mint.Contract.StorageLayout.Owner, config.OwnerAddress.Hash())
+		stateDb.SetState(mint.Contract.Address, mint.Contract.StorageLayout.MintLimit, common.BigToHash(config.MintLimit))
+		stateDb.SetState(mint.Contract.Address, mint.Contract.StorageLayout.NetworksRoot, networksRoot)
+		stateDb.SetState(mint.Contract.Address, mint.Contract.StorageLayout.OwnersRoot, mint.OwnersRoot(finalOwners))
+		stateDb.SetState(mint.Contract.Address, mint.Contract.StorageLayout.Threshold, common.BigToHash(new(big.Int).SetUint64(uint64(finalThreshold))))
+		mint.PersistOwners(stateDb, finalOwners)
+
+		if config.RefillPeriodBlocks > 0 {
+			stateDb.SetState(mint.Contract.Address, mint.Contract.StorageLayout.CurrentBudget, common.BigToHash(config.MintLimit))
+			stateDb.SetState(mint.Contract.Address, mint.Contract.StorageLayout.LastRefillBlock, common.Hash{})
+		}
+
+		for _, processed := range config.ProcessedBurns {
+			slot := mint.ProcessedBurnSlot(processed.Network, processed.BurnTxHash)
+			stateDb.SetState(mint.Contract.Address, slot, common.BigToHash(new(big.Int).SetUint64(processed.BlockNumber)))
+		}
+	}, nil
+}
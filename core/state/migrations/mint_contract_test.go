@@ -2,8 +2,12 @@ package migrations
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/mint"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/assert"
+	"math/big"
 	"testing"
 )
 
@@ -14,6 +18,41 @@ func TestInvalidConfig(t *testing.T) {
 	}{
 		{&params.MintContractConfig{}, "owner address is not specified or equals to zero address"},
 		{&params.MintContractConfig{OwnerAddress: common.BytesToAddress([]byte{1})}, "mint limit is not specified"},
+		{
+			&params.MintContractConfig{
+				OwnerAddress:       common.BytesToAddress([]byte{1}),
+				MintLimit:          big.NewInt(1),
+				RefillPeriodBlocks: 100,
+			},
+			"refill amount is not specified",
+		},
+		{
+			&params.MintContractConfig{
+				OwnerAddress:       common.BytesToAddress([]byte{1}),
+				MintLimit:          big.NewInt(1),
+				RefillPeriodBlocks: 100,
+				RefillAmount:       big.NewInt(1),
+			},
+			"max budget is not specified",
+		},
+		{
+			&params.MintContractConfig{
+				OwnerAddress: common.BytesToAddress([]byte{1}),
+				MintLimit:    big.NewInt(1),
+				Owners:       []common.Address{common.BytesToAddress([]byte{1}), common.BytesToAddress([]byte{2})},
+				Threshold:    0,
+			},
+			"owner threshold is not specified",
+		},
+		{
+			&params.MintContractConfig{
+				OwnerAddress: common.BytesToAddress([]byte{1}),
+				MintLimit:    big.NewInt(1),
+				Owners:       []common.Address{common.BytesToAddress([]byte{1}), common.BytesToAddress([]byte{2})},
+				Threshold:    3,
+			},
+			"owner threshold exceeds the number of owners",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -23,3 +62,35 @@ func TestInvalidConfig(t *testing.T) {
 		})
 	}
 }
+
+// TestMigrationPreservesRotatedOwners simulates a node restart: re-running
+// the migration against a stateDb that already has a rotated owner set
+// persisted must reload that set rather than reinstalling config.Owners.
+func TestMigrationPreservesRotatedOwners(t *testing.T) {
+	config := &params.MintContractConfig{
+		OwnerAddress: common.BytesToAddress([]byte{1}),
+		MintLimit:    big.NewInt(1),
+	}
+
+	stateDb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+
+	migrate, err := NewMintContractMigration(config)
+	assert.NoError(t, err)
+	migrate(stateDb)
+
+	assert.Equal(t, []common.Address{config.OwnerAddress}, mint.Owners)
+	assert.Equal(t, uint8(1), mint.Threshold)
+
+	rotatedOwners := []common.Address{common.BytesToAddress([]byte{2}), common.BytesToAddress([]byte{3})}
+	rotatedThreshold := uint8(2)
+	stateDb.SetState(mint.Contract.Address, mint.Contract.StorageLayout.Threshold, common.BigToHash(new(big.Int).SetUint64(uint64(rotatedThreshold))))
+	mint.PersistOwners(stateDb, rotatedOwners)
+
+	migrate, err = NewMintContractMigration(config)
+	assert.NoError(t, err)
+	migrate(stateDb)
+
+	assert.Equal(t, rotatedOwners, mint.Owners)
+	assert.Equal(t, rotatedThreshold, mint.Threshold)
+	assert.Equal(t, mint.OwnersRoot(rotatedOwners), stateDb.GetState(mint.Contract.Address, mint.Contract.StorageLayout.OwnersRoot))
+}
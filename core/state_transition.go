@@ -0,0 +1,322 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/mint"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	ErrNonceTooLow       = errors.New("nonce too low")
+	ErrNonceTooHigh      = errors.New("nonce too high")
+	ErrGasLimitReached   = errors.New("gas limit reached")
+	ErrInsufficientFunds = errors.New("insufficient funds for gas * price + value")
+	ErrGasUintOverflow   = errors.New("gas uint64 overflow")
+	ErrIntrinsicGas      = errors.New("intrinsic gas too low")
+)
+
+// mintInstructionGas is the fixed gas surcharge, on top of the intrinsic
+// transaction gas, charged for a native mint instruction. It stands in for
+// the state-write and log costs a handwritten contract would pay for the
+// same state changes, without forcing every mint tx through the interpreter.
+const mintInstructionGas = 716
+
+// GasPool tracks the amount of gas available during the execution of the
+// transactions in a block.
+type GasPool uint64
+
+// AddGas makes gas available for execution.
+func (gp *GasPool) AddGas(amount uint64) *GasPool {
+	if uint64(*gp) > math.MaxUint64-amount {
+		panic("gas pool pushed above uint64")
+	}
+	*(*uint64)(gp) += amount
+	return gp
+}
+
+// SubGas deducts the given amount from the pool if enough gas is available
+// and returns an error otherwise.
+func (gp *GasPool) SubGas(amount uint64) error {
+	if uint64(*gp) < amount {
+		return ErrGasLimitReached
+	}
+	*(*uint64)(gp) -= amount
+	return nil
+}
+
+// Gas returns the amount of gas remaining in the pool.
+func (gp *GasPool) Gas() uint64 {
+	return uint64(*gp)
+}
+
+func (gp *GasPool) String() string {
+	return fmt.Sprintf("%d", *gp)
+}
+
+// ExecutionResult describes the result of a state transition.
+type ExecutionResult struct {
+	UsedGas    uint64
+	Err        error
+	ReturnData []byte
+}
+
+// Failed reports whether the transaction was reverted or ran out of gas.
+func (result *ExecutionResult) Failed() bool { return result.Err != nil }
+
+// Return returns the data after execution if no error occurred.
+func (result *ExecutionResult) Return() []byte {
+	if result.Err != nil {
+		return nil
+	}
+	return common.CopyBytes(result.ReturnData)
+}
+
+// IntrinsicGas computes the 'intrinsic gas' for a message with the given data.
+func IntrinsicGas(data []byte, accessList types.AccessList, isContractCreation, isHomestead, isEIP2028 bool) (uint64, error) {
+	var gas uint64
+	if isContractCreation && isHomestead {
+		gas = params.TxGasContractCreation
+	} else {
+		gas = params.TxGas
+	}
+	if len(data) > 0 {
+		var nz uint64
+		for _, b := range data {
+			if b != 0 {
+				nz++
+			}
+		}
+		nonZeroGas := uint64(params.TxDataNonZeroGasFrontier)
+		if isEIP2028 {
+			nonZeroGas = params.TxDataNonZeroGasEIP2028
+		}
+		if (math.MaxUint64-gas)/nonZeroGas < nz {
+			return 0, ErrGasUintOverflow
+		}
+		gas += nz * nonZeroGas
+
+		z := uint64(len(data)) - nz
+		if (math.MaxUint64-gas)/params.TxDataZeroGas < z {
+			return 0, ErrGasUintOverflow
+		}
+		gas += z * params.TxDataZeroGas
+	}
+	if accessList != nil {
+		gas += uint64(len(accessList)) * params.TxAccessListAddressGas
+		gas += uint64(accessList.StorageKeys()) * params.TxAccessListStorageKeyGas
+	}
+	return gas, nil
+}
+
+// StateTransition represents a state transition.
+//
+// == The state transitioning model
+//
+// A state transition is a change made when a transaction is applied to the
+// current world state. The state transitioning model does all the necessary
+// work to work out a valid new state root.
+//
+//  1. Nonce handling
+//  2. Pre pay gas
+//  3. Create a new state object if the recipient is nil
+//  4. Value transfer, or native mint execution if the recipient is the mint
+//     contract
+//
+// == If contract creation ==
+//
+//  4a. Attempt to run transaction data
+//  4b. If valid, use result as code for the new state object
+//
+// == end ==
+//
+//  5. Run Script section
+//  6. Derive new state root
+type StateTransition struct {
+	gp         *GasPool
+	msg        types.Message
+	gas        uint64
+	gasPrice   *big.Int
+	initialGas uint64
+	value      *big.Int
+	data       []byte
+	state      vm.StateDB
+	evm        *vm.EVM
+}
+
+// NewStateTransition initialises and returns a new state transition object.
+func NewStateTransition(evm *vm.EVM, msg types.Message, gp *GasPool) *StateTransition {
+	return &StateTransition{
+		gp:       gp,
+		evm:      evm,
+		msg:      msg,
+		gasPrice: msg.GasPrice(),
+		value:    msg.Value(),
+		data:     msg.Data(),
+		state:    evm.StateDB,
+	}
+}
+
+// ApplyMessage computes the new state by applying the given message against
+// the old state within the environment.
+//
+// ApplyMessage returns the bytes returned by any EVM execution (if it took
+// place), the gas used (which includes gas refunds) and an error if it
+// failed. An error always indicates a core error meaning that the message
+// would always fail for that particular state and would never be accepted
+// within a block.
+func ApplyMessage(evm *vm.EVM, msg types.Message, gp *GasPool) (*ExecutionResult, error) {
+	return NewStateTransition(evm, msg, gp).TransitionDb()
+}
+
+func (st *StateTransition) to() common.Address {
+	if st.msg == nil || st.msg.To() == nil {
+		return common.Address{}
+	}
+	return *st.msg.To()
+}
+
+func (st *StateTransition) buyGas() error {
+	mgval := new(big.Int).Mul(new(big.Int).SetUint64(st.msg.Gas()), st.gasPrice)
+	if have, want := st.state.GetBalance(st.msg.From()), mgval; have.Cmp(want) < 0 {
+		return fmt.Errorf("%w: address %v have %v want %v", ErrInsufficientFunds, st.msg.From().Hex(), have, want)
+	}
+	if err := st.gp.SubGas(st.msg.Gas()); err != nil {
+		return err
+	}
+	st.gas += st.msg.Gas()
+	st.initialGas = st.msg.Gas()
+	st.state.SubBalance(st.msg.From(), mgval)
+	return nil
+}
+
+func (st *StateTransition) preCheck() error {
+	if st.msg.CheckNonce() {
+		stNonce := st.state.GetNonce(st.msg.From())
+		if msgNonce := st.msg.Nonce(); stNonce < msgNonce {
+			return fmt.Errorf("%w: address %v, tx: %d state: %d", ErrNonceTooHigh, st.msg.From().Hex(), msgNonce, stNonce)
+		} else if stNonce > msgNonce {
+			return fmt.Errorf("%w: address %v, tx: %d state: %d", ErrNonceTooLow, st.msg.From().Hex(), msgNonce, stNonce)
+		}
+	}
+	return st.buyGas()
+}
+
+func (st *StateTransition) gasUsed() uint64 {
+	return st.initialGas - st.gas
+}
+
+// refundGas returns ETH for remaining gas, exchanged at the original rate,
+// and credits it against the gas pool so it is available to later
+// transactions in the same block.
+func (st *StateTransition) refundGas(refundQuotient uint64) {
+	refund := st.gasUsed() / refundQuotient
+	if available := st.state.GetRefund(); refund > available {
+		refund = available
+	}
+	st.gas += refund
+
+	remaining := new(big.Int).Mul(new(big.Int).SetUint64(st.gas), st.gasPrice)
+	st.state.AddBalance(st.msg.From(), remaining)
+
+	st.gp.AddGas(st.gas)
+}
+
+// TransitionDb will transition the state by applying the current message and
+// returning the evm execution result with following fields.
+//
+//   - used gas: total gas used (including gas refunds)
+//   - returndata: the returned data from evm
+//   - concrete execution error: various EVM errors which abort the execution,
+//     e.g. ErrExecutionReverted
+//
+// However if any consensus issue encountered, return the error directly with
+// nil evm execution result.
+func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
+	if err := st.preCheck(); err != nil {
+		return nil, err
+	}
+	msg := st.msg
+	sender := vm.AccountRef(msg.From())
+	blockNumber := st.evm.Context.BlockNumber
+	homestead := st.evm.ChainConfig().IsHomestead(blockNumber)
+	istanbul := st.evm.ChainConfig().IsIstanbul(blockNumber)
+	contractCreation := msg.To() == nil
+
+	// effectiveTip is the per-gas amount actually credited to the coinbase:
+	// st.gasPrice is already min(GasFeeCap, BaseFee+GasTipCap), so subtracting
+	// BaseFee gives the real tip, which can be far below GasTipCap when the
+	// fee cap binds. The MinTipCap anti-flood check below must compare
+	// against this, not the raw GasTipCap, or a high GasTipCap paired with a
+	// low GasFeeCap would pass the check while paying a negligible tip.
+	effectiveTip := st.gasPrice
+	if baseFee := st.evm.Context.BaseFee; baseFee != nil {
+		effectiveTip = new(big.Int).Sub(st.gasPrice, baseFee)
+		if effectiveTip.Sign() < 0 {
+			effectiveTip = new(big.Int)
+		}
+	}
+
+	gas, err := IntrinsicGas(st.data, msg.AccessList(), contractCreation, homestead, istanbul)
+	if err != nil {
+		return nil, err
+	}
+	if st.gas < gas {
+		return nil, ErrIntrinsicGas
+	}
+	st.gas -= gas
+
+	var (
+		ret   []byte
+		vmerr error
+		nonce = st.state.GetNonce(msg.From())
+	)
+	switch {
+	case !contractCreation && st.to() == mint.Contract.Address:
+		switch {
+		case st.gas < mintInstructionGas:
+			vmerr = vm.ErrOutOfGas
+		case len(st.data) < 1:
+			log.Warn("missing mint opcode")
+			vmerr = vm.ErrExecutionReverted
+		case mint.MinTipCap != nil && effectiveTip.Cmp(mint.MinTipCap) < 0:
+			log.Warn("mint tip below required minimum")
+			vmerr = vm.ErrExecutionReverted
+		default:
+			st.gas -= mintInstructionGas
+			snapshot := st.state.Snapshot()
+			opcode, payload := mint.Opcode(st.data[0]), st.data[1:]
+			if opcode == mint.OpcodeRotateOwners {
+				vmerr = mint.RotateOwners(st.state, msg.From(), payload, blockNumber.Uint64())
+			} else {
+				vmerr = mint.Execute(st.state, msg.From(), payload, blockNumber.Uint64())
+			}
+			if vmerr != nil {
+				st.state.RevertToSnapshot(snapshot)
+			}
+		}
+		st.state.SetNonce(msg.From(), nonce+1)
+	case contractCreation:
+		ret, _, st.gas, vmerr = st.evm.Create(sender, st.data, st.gas, st.value)
+	default:
+		st.state.SetNonce(msg.From(), nonce+1)
+		ret, st.gas, vmerr = st.evm.Call(sender, st.to(), st.data, st.gas, st.value)
+	}
+
+	st.refundGas(params.RefundQuotient)
+
+	st.state.AddBalance(st.evm.Context.Coinbase, new(big.Int).Mul(new(big.Int).SetUint64(st.gasUsed()), effectiveTip))
+
+	return &ExecutionResult{
+		UsedGas:    st.gasUsed(),
+		Err:        vmerr,
+		ReturnData: ret,
+	}, nil
+}
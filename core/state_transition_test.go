@@ -3,6 +3,7 @@ package core
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"encoding/binary"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/mint"
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -22,6 +23,11 @@ var ownerKey, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668
 var ownerAddr = crypto.PubkeyToAddress(ownerKey.PublicKey)
 var mintLimit = new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))
 
+// mintNetwork is the network id used throughout these tests, authenticated
+// by a TrustedSignerVerifier over ownerKey - the pluggable-verifier
+// equivalent of the original owner-only minting semantics.
+const mintNetwork = byte(1)
+
 func prepareStateDb() *state.StateDB {
 	stateDb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
 
@@ -31,12 +37,44 @@ func prepareStateDb() *state.StateDB {
 	stateDb.AddBalance(ownerAddr, big.NewInt(params.Ether))
 	stateDb.Finalise(true)
 
+	mint.Verifiers = mint.VerifierRegistry{mintNetwork: &mint.TrustedSignerVerifier{Attester: ownerAddr}}
+	mint.Refill = nil
+	mint.Owners = []common.Address{ownerAddr}
+	mint.Threshold = 1
+	mint.ChainID = big.NewInt(1)
+
 	return stateDb
 }
 
+// mintData builds the OpcodeMint(1) | mintAmount(32) | burnTxHash(32) |
+// network(1) | proofLen(4) | proof tx data expected by the mint contract
+// dispatch in TransitionDb, signing the proof with signerKey when it is
+// non-nil.
+func mintData(mintAmount *big.Int, burnTxHash common.Hash, network byte, signerKey *ecdsa.PrivateKey) []byte {
+	var proof []byte
+	if signerKey != nil {
+		sig, err := crypto.Sign(mint.SigningHash(network, burnTxHash, mintAmount).Bytes(), signerKey)
+		if err != nil {
+			panic(err)
+		}
+		proof = sig
+	}
+
+	proofLen := make([]byte, 4)
+	binary.BigEndian.PutUint32(proofLen, uint32(len(proof)))
+
+	return bytes.Join([][]byte{
+		{byte(mint.OpcodeMint)},
+		common.BigToHash(mintAmount).Bytes(),
+		burnTxHash.Bytes(),
+		{network},
+		proofLen,
+		proof,
+	}, []byte{})
+}
+
 func TestIncorrectMintInstruction(t *testing.T) {
 	sender2Key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f292")
-	sender2Addr := crypto.PubkeyToAddress(sender2Key.PublicKey)
 
 	blockNum := big.NewInt(100)
 	blockCtx := vm.BlockContext{
@@ -49,68 +87,45 @@ func TestIncorrectMintInstruction(t *testing.T) {
 	chainConfig.LondonBlock = nil
 
 	validMintAmount := new(big.Int).Mul(big.NewInt(500), big.NewInt(params.Ether))
-	validData := bytes.Join([][]byte{
-		common.BigToHash(validMintAmount).Bytes(),
-		(common.Hash{}).Bytes(),
-		{byte(1)},
-	}, []byte{})
 
 	testCases := []struct {
 		signerKey     *ecdsa.PrivateKey
-		tx            *types.LegacyTx
+		data          []byte
 		expectedError string
 		modifyStateDb func(stateDb *state.StateDB)
 	}{
 		{
-			signerKey: ownerKey,
-			tx: &types.LegacyTx{
-				Nonce:    0,
-				To:       &mint.Contract.Address,
-				Value:    new(big.Int),
-				Gas:      100000,
-				Data:     bytes.Repeat([]byte{2}, 65),
-				GasPrice: big.NewInt(params.GWei),
-			},
+			signerKey:     ownerKey,
+			data:          mintData(validMintAmount, common.Hash{}, byte(2), ownerKey),
 			expectedError: "invalid burn tx network in mint instruction",
 		},
 		{
-			signerKey: sender2Key,
-			tx: &types.LegacyTx{
-				Nonce:    0,
-				To:       &mint.Contract.Address,
-				Value:    new(big.Int),
-				Gas:      100000,
-				Data:     bytes.Repeat([]byte{0}, 65),
-				GasPrice: big.NewInt(params.GWei),
-			},
+			signerKey:     ownerKey,
+			data:          mintData(validMintAmount, common.Hash{}, mintNetwork, sender2Key),
+			expectedError: "burn proof verification failed",
+		},
+		{
+			signerKey:     sender2Key,
+			data:          mintData(validMintAmount, common.Hash{}, mintNetwork, ownerKey),
 			expectedError: "transaction sender is not allowed to mint",
 			modifyStateDb: func(stateDb *state.StateDB) {
-				stateDb.AddBalance(sender2Addr, big.NewInt(params.Ether))
+				stateDb.AddBalance(crypto.PubkeyToAddress(sender2Key.PublicKey), big.NewInt(params.Ether))
 				stateDb.Finalise(true)
 			},
 		},
 		{
 			signerKey: ownerKey,
-			tx: &types.LegacyTx{
-				Nonce:    0,
-				To:       &mint.Contract.Address,
-				Value:    new(big.Int),
-				Gas:      100000,
-				Data:     bytes.Repeat([]byte{1}, 65),
-				GasPrice: big.NewInt(params.GWei),
-			},
+			data: mintData(
+				new(big.Int).Mul(mintLimit, big.NewInt(2)),
+				common.Hash{},
+				mintNetwork,
+				ownerKey,
+			),
 			expectedError: "mint amount exceeds mint limit",
 		},
 		{
-			signerKey: ownerKey,
-			tx: &types.LegacyTx{
-				Nonce:    0,
-				To:       &mint.Contract.Address,
-				Value:    new(big.Int),
-				Gas:      100000,
-				Data:     validData,
-				GasPrice: big.NewInt(params.GWei),
-			},
+			signerKey:     ownerKey,
+			data:          mintData(validMintAmount, common.Hash{}, mintNetwork, ownerKey),
 			expectedError: "mint contract not found in current state",
 			modifyStateDb: func(stateDb *state.StateDB) {
 				stateDb.SetCode(mint.Contract.Address, mint.Contract.Bytecode[:len(mint.Contract.Bytecode)-106])
@@ -133,7 +148,14 @@ func TestIncorrectMintInstruction(t *testing.T) {
 
 			evm := vm.NewEVM(blockCtx, vm.TxContext{}, stateDb, chainConfig, vm.Config{NoBaseFee: true})
 
-			tx, _ := types.SignNewTx(testCase.signerKey, signer, testCase.tx)
+			tx, _ := types.SignNewTx(testCase.signerKey, signer, &types.LegacyTx{
+				Nonce:    0,
+				To:       &mint.Contract.Address,
+				Value:    new(big.Int),
+				Gas:      100000,
+				Data:     testCase.data,
+				GasPrice: big.NewInt(params.GWei),
+			})
 			message, _ := tx.AsMessage(signer, nil)
 			result, err := ApplyMessage(evm, message, new(GasPool).AddGas(math.MaxUint64))
 
@@ -164,9 +186,8 @@ func TestSuccessfulMint(t *testing.T) {
 
 	mintAmount := new(big.Int).Mul(big.NewInt(500), big.NewInt(params.Ether))
 	burnTxHash := common.HexToHash("0x621c759718a44e19ad04f8d133746b1043a2004f3fd68028cd28f1598388106e")
-	burnTxNetwork := byte(0)
 
-	data := bytes.Join([][]byte{common.BigToHash(mintAmount).Bytes(), burnTxHash.Bytes(), {burnTxNetwork}}, []byte{})
+	data := mintData(mintAmount, burnTxHash, mintNetwork, ownerKey)
 
 	evm := vm.NewEVM(blockCtx, vm.TxContext{}, stateDb, chainConfig, vm.Config{NoBaseFee: true})
 	signer := types.HomesteadSigner{}
@@ -186,16 +207,17 @@ func TestSuccessfulMint(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NoError(t, result.Err)
-	assert.Equal(t, uint64(21716), result.UsedGas)
 
-	// Fee = 21716 * 1 gwei
+	// Fee = UsedGas * 1 gwei
 	// Mint amount = 500 * 1 ether
 	// Previous balance = 1 ether
-	// Expected balance = Previous balance - Fee + Mint amount = 500.999978284 ether
-	expectedBalance, _ := new(big.Int).SetString("500999978284000000000", 10)
+	expectedBalance := new(big.Int).Sub(
+		new(big.Int).Add(big.NewInt(params.Ether), mintAmount),
+		new(big.Int).Mul(new(big.Int).SetUint64(result.UsedGas), big.NewInt(params.GWei)),
+	)
 	// Previous mint limit = 1000
 	// Expected mint limit = Previous mint limit - Mint amount
-	expectedMintLimit := common.BigToHash(new(big.Int).Mul(big.NewInt(500), big.NewInt(params.Ether)))
+	expectedMintLimit := common.BigToHash(new(big.Int).Sub(mintLimit, mintAmount))
 
 	assert.Equal(t, uint64(1), stateDb.GetNonce(ownerAddr))
 	assert.Equal(t, expectedBalance, stateDb.GetBalance(ownerAddr))
@@ -204,12 +226,417 @@ func TestSuccessfulMint(t *testing.T) {
 	assert.Len(t, stateDb.Logs(), 1)
 	assert.Equal(t, &types.Log{
 		Address: mint.Contract.Address,
-		Topics:  []common.Hash{common.HexToHash("0d9811f14a9cfa628d4819902adcdd4ff09f73ac9c2628280058dc2146fa247d")},
+		Topics: []common.Hash{
+			common.HexToHash("0d9811f14a9cfa628d4819902adcdd4ff09f73ac9c2628280058dc2146fa247d"),
+			common.BytesToHash([]byte{mintNetwork}),
+		},
 		Data: bytes.Join([][]byte{
 			common.BigToHash(mintAmount).Bytes(),
 			burnTxHash.Bytes(),
-			common.BytesToHash([]byte{burnTxNetwork}).Bytes(),
+			common.BytesToHash([]byte{mintNetwork}).Bytes(),
 		}, []byte{}),
 		BlockNumber: blockNum.Uint64(),
 	}, stateDb.Logs()[0])
 }
+
+func TestReplayedBurnIsRejected(t *testing.T) {
+	stateDb := prepareStateDb()
+
+	blockNum := big.NewInt(100)
+	blockCtx := vm.BlockContext{
+		CanTransfer: func(db vm.StateDB, address common.Address, b *big.Int) bool { return true },
+		Transfer:    func(db vm.StateDB, address common.Address, address2 common.Address, b *big.Int) {},
+		BlockNumber: blockNum,
+	}
+
+	chainConfig := params.AllCliqueProtocolChanges
+	chainConfig.LondonBlock = nil
+
+	mintAmount := new(big.Int).Mul(big.NewInt(500), big.NewInt(params.Ether))
+	burnTxHash := common.HexToHash("0x621c759718a44e19ad04f8d133746b1043a2004f3fd68028cd28f1598388106e")
+
+	data := mintData(mintAmount, burnTxHash, mintNetwork, ownerKey)
+
+	signer := types.HomesteadSigner{}
+
+	send := func(nonce uint64) (*ExecutionResult, error) {
+		evm := vm.NewEVM(blockCtx, vm.TxContext{}, stateDb, chainConfig, vm.Config{NoBaseFee: true})
+		tx, _ := types.SignNewTx(ownerKey, signer, &types.LegacyTx{
+			Nonce:    nonce,
+			To:       &mint.Contract.Address,
+			Value:    new(big.Int),
+			Gas:      100000,
+			Data:     data,
+			GasPrice: big.NewInt(params.GWei),
+		})
+		message, _ := tx.AsMessage(signer, nil)
+		return ApplyMessage(evm, message, new(GasPool).AddGas(math.MaxUint64))
+	}
+
+	result, err := send(0)
+	assert.NoError(t, err)
+	assert.NoError(t, result.Err)
+
+	logRecords := make([]*log.Record, 0)
+	log.Root().SetHandler(log.FuncHandler(func(r *log.Record) error { logRecords = append(logRecords, r); return nil }))
+
+	result, err = send(1)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, result.Err, vm.ErrExecutionReverted)
+	assert.Len(t, logRecords, 1)
+	assert.Equal(t, "burn tx already processed", logRecords[0].Msg)
+}
+
+// TestMintFeeHandling exercises the EIP-1559 fee split for mint
+// transactions across legacy, access-list and dynamic-fee tx types, both
+// pre- and post-London: the sender always pays gasPrice (or the effective
+// EIP-1559 price) per gas, but only the tip portion - not the base fee - is
+// credited to the coinbase.
+func TestMintFeeHandling(t *testing.T) {
+	mintAmount := new(big.Int).Mul(big.NewInt(500), big.NewInt(params.Ether))
+	burnTxHash := common.HexToHash("0x621c759718a44e19ad04f8d133746b1043a2004f3fd68028cd28f1598388106e")
+	data := mintData(mintAmount, burnTxHash, mintNetwork, ownerKey)
+
+	gwei := func(n int64) *big.Int { return new(big.Int).Mul(big.NewInt(n), big.NewInt(params.GWei)) }
+
+	testCases := []struct {
+		name        string
+		london      bool
+		baseFee     *big.Int
+		buildTxData types.TxData
+		expectedTip *big.Int
+	}{
+		{
+			name:        "legacy pre-London",
+			london:      false,
+			baseFee:     nil,
+			buildTxData: &types.LegacyTx{GasPrice: gwei(10)},
+			expectedTip: gwei(10),
+		},
+		{
+			name:        "legacy post-London",
+			london:      true,
+			baseFee:     gwei(1),
+			buildTxData: &types.LegacyTx{GasPrice: gwei(10)},
+			expectedTip: gwei(9),
+		},
+		{
+			name:        "access-list post-London",
+			london:      true,
+			baseFee:     gwei(1),
+			buildTxData: &types.AccessListTx{GasPrice: gwei(10)},
+			expectedTip: gwei(9),
+		},
+		{
+			name:        "dynamic-fee post-London, tip unconstrained",
+			london:      true,
+			baseFee:     gwei(1),
+			buildTxData: &types.DynamicFeeTx{GasFeeCap: gwei(10), GasTipCap: gwei(3)},
+			expectedTip: gwei(3),
+		},
+		{
+			name:        "dynamic-fee post-London, fee cap binds",
+			london:      true,
+			baseFee:     gwei(1),
+			buildTxData: &types.DynamicFeeTx{GasFeeCap: gwei(4), GasTipCap: gwei(10)},
+			expectedTip: gwei(3),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stateDb := prepareStateDb()
+
+			blockNum := big.NewInt(100)
+			blockCtx := vm.BlockContext{
+				CanTransfer: func(db vm.StateDB, address common.Address, b *big.Int) bool { return true },
+				Transfer:    func(db vm.StateDB, address common.Address, address2 common.Address, b *big.Int) {},
+				BlockNumber: blockNum,
+				BaseFee:     testCase.baseFee,
+			}
+
+			chainConfig := *params.AllCliqueProtocolChanges
+			chainConfig.LondonBlock = nil
+			if testCase.london {
+				chainConfig.LondonBlock = big.NewInt(0)
+			}
+			signer := types.NewLondonSigner(chainConfig.ChainID)
+
+			switch txData := testCase.buildTxData.(type) {
+			case *types.LegacyTx:
+				txData.Nonce, txData.To, txData.Value, txData.Gas, txData.Data = 0, &mint.Contract.Address, new(big.Int), 100000, data
+			case *types.AccessListTx:
+				txData.Nonce, txData.To, txData.Value, txData.Gas, txData.Data = 0, &mint.Contract.Address, new(big.Int), 100000, data
+			case *types.DynamicFeeTx:
+				txData.Nonce, txData.To, txData.Value, txData.Gas, txData.Data = 0, &mint.Contract.Address, new(big.Int), 100000, data
+			}
+
+			tx, err := types.SignNewTx(ownerKey, signer, testCase.buildTxData)
+			assert.NoError(t, err)
+
+			evm := vm.NewEVM(blockCtx, vm.TxContext{}, stateDb, &chainConfig, vm.Config{NoBaseFee: testCase.baseFee == nil})
+
+			message, err := tx.AsMessage(signer, testCase.baseFee)
+			assert.NoError(t, err)
+
+			result, err := ApplyMessage(evm, message, new(GasPool).AddGas(math.MaxUint64))
+			assert.NoError(t, err)
+			assert.NoError(t, result.Err)
+
+			fee := new(big.Int).Mul(new(big.Int).SetUint64(result.UsedGas), message.GasPrice())
+			expectedBalance := new(big.Int).Sub(new(big.Int).Add(big.NewInt(params.Ether), mintAmount), fee)
+			assert.Equal(t, expectedBalance, stateDb.GetBalance(ownerAddr))
+
+			expectedCoinbase := new(big.Int).Mul(new(big.Int).SetUint64(result.UsedGas), testCase.expectedTip)
+			assert.Equal(t, expectedCoinbase, stateDb.GetBalance(evm.Context.Coinbase))
+		})
+	}
+}
+
+// TestMintRequiresMinTipCap checks that mint.MinTipCap, when configured,
+// rejects mint transactions whose priority fee falls short of it before
+// mint.Execute ever runs.
+func TestMintRequiresMinTipCap(t *testing.T) {
+	stateDb := prepareStateDb()
+	mint.MinTipCap = new(big.Int).Mul(big.NewInt(5), big.NewInt(params.GWei))
+	defer func() { mint.MinTipCap = nil }()
+
+	blockNum := big.NewInt(100)
+	baseFee := new(big.Int).Mul(big.NewInt(1), big.NewInt(params.GWei))
+	blockCtx := vm.BlockContext{
+		CanTransfer: func(db vm.StateDB, address common.Address, b *big.Int) bool { return true },
+		Transfer:    func(db vm.StateDB, address common.Address, address2 common.Address, b *big.Int) {},
+		BlockNumber: blockNum,
+		BaseFee:     baseFee,
+	}
+
+	chainConfig := params.AllCliqueProtocolChanges
+	signer := types.NewLondonSigner(chainConfig.ChainID)
+
+	mintAmount := new(big.Int).Mul(big.NewInt(500), big.NewInt(params.Ether))
+	burnTxHash := common.HexToHash("0x621c759718a44e19ad04f8d133746b1043a2004f3fd68028cd28f1598388106e")
+	data := mintData(mintAmount, burnTxHash, mintNetwork, ownerKey)
+
+	tx, err := types.SignNewTx(ownerKey, signer, &types.DynamicFeeTx{
+		Nonce:     0,
+		To:        &mint.Contract.Address,
+		Value:     new(big.Int),
+		Gas:       100000,
+		Data:      data,
+		GasFeeCap: new(big.Int).Mul(big.NewInt(10), big.NewInt(params.GWei)),
+		GasTipCap: new(big.Int).Mul(big.NewInt(3), big.NewInt(params.GWei)),
+	})
+	assert.NoError(t, err)
+
+	logRecords := make([]*log.Record, 0)
+	log.Root().SetHandler(log.FuncHandler(func(r *log.Record) error { logRecords = append(logRecords, r); return nil }))
+
+	evm := vm.NewEVM(blockCtx, vm.TxContext{}, stateDb, chainConfig, vm.Config{})
+	message, err := tx.AsMessage(signer, baseFee)
+	assert.NoError(t, err)
+
+	result, err := ApplyMessage(evm, message, new(GasPool).AddGas(math.MaxUint64))
+	assert.NoError(t, err)
+	assert.ErrorIs(t, result.Err, vm.ErrExecutionReverted)
+	assert.Len(t, logRecords, 1)
+	assert.Equal(t, "mint tip below required minimum", logRecords[0].Msg)
+}
+
+func TestMintBudgetRefill(t *testing.T) {
+	stateDb := prepareStateDb()
+
+	refillAmount := new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+	refillPeriodBlocks := uint64(10)
+	maxBudget := new(big.Int).Mul(big.NewInt(1200), big.NewInt(params.Ether))
+
+	mint.Refill = &mint.RefillConfig{
+		RefillAmount:       refillAmount,
+		RefillPeriodBlocks: refillPeriodBlocks,
+		MaxBudget:          maxBudget,
+	}
+	// CurrentBudget starts equal to MintLimit, as migrations.NewMintContractMigration seeds it.
+	stateDb.SetState(mint.Contract.Address, mint.Contract.StorageLayout.CurrentBudget, common.BigToHash(mintLimit))
+	stateDb.Finalise(true)
+
+	chainConfig := params.AllCliqueProtocolChanges
+	chainConfig.LondonBlock = nil
+
+	signer := types.HomesteadSigner{}
+
+	mintOnce := func(blockNumber uint64, mintAmount *big.Int, burnTxHash common.Hash, nonce uint64) (*ExecutionResult, error) {
+		blockCtx := vm.BlockContext{
+			CanTransfer: func(db vm.StateDB, address common.Address, b *big.Int) bool { return true },
+			Transfer:    func(db vm.StateDB, address common.Address, address2 common.Address, b *big.Int) {},
+			BlockNumber: new(big.Int).SetUint64(blockNumber),
+		}
+		evm := vm.NewEVM(blockCtx, vm.TxContext{}, stateDb, chainConfig, vm.Config{NoBaseFee: true})
+		tx, _ := types.SignNewTx(ownerKey, signer, &types.LegacyTx{
+			Nonce:    nonce,
+			To:       &mint.Contract.Address,
+			Value:    new(big.Int),
+			Gas:      100000,
+			Data:     mintData(mintAmount, burnTxHash, mintNetwork, ownerKey),
+			GasPrice: big.NewInt(params.GWei),
+		})
+		message, _ := tx.AsMessage(signer, nil)
+		return ApplyMessage(evm, message, new(GasPool).AddGas(math.MaxUint64))
+	}
+
+	// Exhaust the initial 1000 ether budget at block 100.
+	result, err := mintOnce(100, mintLimit, common.HexToHash("0x01"), 0)
+	assert.NoError(t, err)
+	assert.NoError(t, result.Err)
+	assert.Equal(t, common.Hash{}, stateDb.GetState(mint.Contract.Address, mint.Contract.StorageLayout.CurrentBudget))
+
+	// A mint before the next refill period still fails.
+	result, err = mintOnce(105, big.NewInt(1), common.HexToHash("0x02"), 1)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, result.Err, vm.ErrExecutionReverted)
+
+	// 3 periods (30 blocks) later the budget refills by 3 * 100 ether.
+	logsBefore := len(stateDb.Logs())
+	refilledAmount := new(big.Int).Mul(big.NewInt(300), big.NewInt(params.Ether))
+	result, err = mintOnce(130, refilledAmount, common.HexToHash("0x03"), 2)
+	assert.NoError(t, err)
+	assert.NoError(t, result.Err)
+	assert.Equal(t, common.Hash{}, stateDb.GetState(mint.Contract.Address, mint.Contract.StorageLayout.CurrentBudget))
+
+	refillLogs := 0
+	for _, l := range stateDb.Logs()[logsBefore:] {
+		if len(l.Topics) > 0 && l.Topics[0] == common.HexToHash("8a2a9e2c7dca6b0e2c3c0c9b0e28c0f7a1e9f8f1a5f2e6c4b3d7a9c1e0f4b6a2") {
+			refillLogs++
+		}
+	}
+	assert.Equal(t, 1, refillLogs, "expected exactly one BudgetRefilled log for this mint")
+
+	// The budget never grows past MaxBudget however many periods elapse.
+	result, err = mintOnce(100_000, maxBudget, common.HexToHash("0x04"), 3)
+	assert.NoError(t, err)
+	assert.NoError(t, result.Err)
+	assert.Equal(t, common.Hash{}, stateDb.GetState(mint.Contract.Address, mint.Contract.StorageLayout.CurrentBudget))
+}
+
+// sigTail builds the sigCount(1) | sig1(65) | sig2(65) | ... block appended
+// after a mint or owner-rotation instruction's own payload in multisig mode.
+func sigTail(signingHash common.Hash, keys ...*ecdsa.PrivateKey) []byte {
+	tail := []byte{byte(len(keys))}
+	for _, key := range keys {
+		sig, err := crypto.Sign(signingHash.Bytes(), key)
+		if err != nil {
+			panic(err)
+		}
+		tail = append(tail, sig...)
+	}
+	return tail
+}
+
+func TestMultisigMint(t *testing.T) {
+	stateDb := prepareStateDb()
+
+	owner1Key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f293")
+	owner2Key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f294")
+	owner3Key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f295")
+	mint.Owners = []common.Address{
+		crypto.PubkeyToAddress(owner1Key.PublicKey),
+		crypto.PubkeyToAddress(owner2Key.PublicKey),
+		crypto.PubkeyToAddress(owner3Key.PublicKey),
+	}
+	mint.Threshold = 2
+	stateDb.AddBalance(crypto.PubkeyToAddress(owner1Key.PublicKey), big.NewInt(params.Ether))
+
+	blockNum := big.NewInt(100)
+	blockCtx := vm.BlockContext{
+		CanTransfer: func(db vm.StateDB, address common.Address, b *big.Int) bool { return true },
+		Transfer:    func(db vm.StateDB, address common.Address, address2 common.Address, b *big.Int) {},
+		BlockNumber: blockNum,
+	}
+	chainConfig := params.AllCliqueProtocolChanges
+	chainConfig.LondonBlock = nil
+	signer := types.HomesteadSigner{}
+
+	mintAmount := new(big.Int).Mul(big.NewInt(500), big.NewInt(params.Ether))
+	burnTxHash := common.HexToHash("0x621c759718a44e19ad04f8d133746b1043a2004f3fd68028cd28f1598388106e")
+
+	send := func(nonce uint64, ownerNonce uint64, sigs []byte) (*ExecutionResult, error) {
+		data := append(mintData(mintAmount, burnTxHash, mintNetwork, ownerKey), sigs...)
+		evm := vm.NewEVM(blockCtx, vm.TxContext{}, stateDb, chainConfig, vm.Config{NoBaseFee: true})
+		tx, _ := types.SignNewTx(owner1Key, signer, &types.LegacyTx{
+			Nonce:    nonce,
+			To:       &mint.Contract.Address,
+			Value:    new(big.Int),
+			Gas:      100000,
+			Data:     data,
+			GasPrice: big.NewInt(params.GWei),
+		})
+		message, _ := tx.AsMessage(signer, nil)
+		return ApplyMessage(evm, message, new(GasPool).AddGas(math.MaxUint64))
+	}
+
+	signingHash := mint.MintSigningHash(mint.ChainID, mintAmount, burnTxHash, mintNetwork, 0)
+
+	// A single owner signature is not enough when Threshold is 2.
+	result, err := send(0, 0, sigTail(signingHash, owner1Key))
+	assert.NoError(t, err)
+	assert.ErrorIs(t, result.Err, vm.ErrExecutionReverted)
+
+	// Two distinct owner signatures meet the threshold.
+	result, err = send(1, 0, sigTail(signingHash, owner1Key, owner2Key))
+	assert.NoError(t, err)
+	assert.NoError(t, result.Err)
+	assert.Equal(t, common.BigToHash(big.NewInt(1)), stateDb.GetState(mint.Contract.Address, mint.Contract.StorageLayout.OwnerNonce))
+
+	// Replaying the same signatures fails now that OwnerNonce has advanced.
+	result, err = send(2, 0, sigTail(signingHash, owner1Key, owner2Key))
+	assert.NoError(t, err)
+	assert.ErrorIs(t, result.Err, vm.ErrExecutionReverted)
+}
+
+func TestRotateOwners(t *testing.T) {
+	stateDb := prepareStateDb()
+
+	blockNum := big.NewInt(100)
+	blockCtx := vm.BlockContext{
+		CanTransfer: func(db vm.StateDB, address common.Address, b *big.Int) bool { return true },
+		Transfer:    func(db vm.StateDB, address common.Address, address2 common.Address, b *big.Int) {},
+		BlockNumber: blockNum,
+	}
+	chainConfig := params.AllCliqueProtocolChanges
+	chainConfig.LondonBlock = nil
+	signer := types.HomesteadSigner{}
+
+	newOwner1Key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f296")
+	newOwner2Key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f297")
+	newOwners := []common.Address{
+		crypto.PubkeyToAddress(newOwner1Key.PublicKey),
+		crypto.PubkeyToAddress(newOwner2Key.PublicKey),
+	}
+	newThreshold := uint8(2)
+
+	signingHash := mint.RotationSigningHash(mint.ChainID, newOwners, newThreshold, 0)
+	payload := bytes.Join([][]byte{
+		{byte(mint.OpcodeRotateOwners)},
+		{newThreshold, byte(len(newOwners))},
+		newOwners[0].Bytes(),
+		newOwners[1].Bytes(),
+		sigTail(signingHash, ownerKey),
+	}, []byte{})
+
+	evm := vm.NewEVM(blockCtx, vm.TxContext{}, stateDb, chainConfig, vm.Config{NoBaseFee: true})
+	tx, _ := types.SignNewTx(ownerKey, signer, &types.LegacyTx{
+		Nonce:    0,
+		To:       &mint.Contract.Address,
+		Value:    new(big.Int),
+		Gas:      100000,
+		Data:     payload,
+		GasPrice: big.NewInt(params.GWei),
+	})
+	message, _ := tx.AsMessage(signer, nil)
+	result, err := ApplyMessage(evm, message, new(GasPool).AddGas(math.MaxUint64))
+
+	assert.NoError(t, err)
+	assert.NoError(t, result.Err)
+	assert.Equal(t, newOwners, mint.Owners)
+	assert.Equal(t, newThreshold, mint.Threshold)
+	assert.Equal(t, mint.OwnersRoot(newOwners), stateDb.GetState(mint.Contract.Address, mint.Contract.StorageLayout.OwnersRoot))
+	assert.Equal(t, common.BigToHash(big.NewInt(1)), stateDb.GetState(mint.Contract.Address, mint.Contract.StorageLayout.OwnerNonce))
+}
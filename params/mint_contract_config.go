@@ -0,0 +1,78 @@
+package params
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MintContractConfig configures the privileged mint contract that
+// migrations.NewMintContractMigration installs into state.
+type MintContractConfig struct {
+	// OwnerAddress is written to the mint contract's Owner slot. When Owners
+	// is empty it is the sole owner allowed to submit mint transactions, and
+	// when Networks is empty it is the attester for network id 1.
+	OwnerAddress common.Address
+
+	// MintLimit is the initial mint budget, debited on every successful mint.
+	MintLimit *big.Int
+
+	// ProcessedBurns optionally pre-seeds the replay-protection registry so
+	// that a chain reboot does not allow previously processed burns to be
+	// minted a second time. Each entry marks (Network, BurnTxHash) as
+	// processed as of BlockNumber.
+	ProcessedBurns []ProcessedBurn
+
+	// Networks configures, per source network id, which mint.BurnVerifier
+	// authenticates burns reported from it. When empty, a single
+	// TrustedSignerVerifier over OwnerAddress is installed for network id 1,
+	// reproducing the original owner-only minting semantics.
+	Networks []MintNetworkConfig
+
+	// RefillAmount, RefillPeriodBlocks and MaxBudget switch the mint budget
+	// from the legacy monotonically decreasing MintLimit to a rate-limited
+	// budget: every RefillPeriodBlocks blocks the budget grows by
+	// RefillAmount, capped at MaxBudget. Leave RefillPeriodBlocks at zero to
+	// keep the legacy fixed-limit behavior.
+	RefillAmount       *big.Int
+	RefillPeriodBlocks uint64
+	MaxBudget          *big.Int
+
+	// MinTipCap, when set, requires every mint transaction's effective
+	// priority fee (its gas price minus the block base fee, or the whole gas
+	// price when there is no base fee) to be at least this much, to
+	// discourage flooding the privileged mint path with minimum-fee
+	// transactions.
+	MinTipCap *big.Int
+
+	// Owners and Threshold configure M-of-N multisig authorization for mint
+	// and owner-rotation transactions: at least Threshold of Owners must
+	// sign. When Owners is empty it defaults to []common.Address{OwnerAddress}
+	// with Threshold 1, which - being a single owner at threshold 1 - falls
+	// back to a plain tx-sender check, reproducing the original
+	// single-owner semantics without requiring an attached signature block.
+	Owners    []common.Address
+	Threshold uint8
+
+	// ChainID binds owner signatures to this chain, so a signature collected
+	// for one deployment cannot be replayed on another.
+	ChainID *big.Int
+}
+
+// MintNetworkConfig binds a source network id to the burn verifier that
+// authenticates mint instructions claiming a burn on that network.
+// VerifierParams is interpreted according to VerifierType, see
+// mint.BuildVerifierRegistry.
+type MintNetworkConfig struct {
+	ID             byte
+	VerifierType   string
+	VerifierParams []byte
+}
+
+// ProcessedBurn records a (network, burn tx hash) pair that must be marked
+// as already processed when the mint contract is (re-)installed.
+type ProcessedBurn struct {
+	Network     byte
+	BurnTxHash  common.Hash
+	BlockNumber uint64
+}